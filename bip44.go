@@ -0,0 +1,94 @@
+package secp256k1
+
+import "fmt"
+
+// BIP44Params describes the 5-level derivation path defined by
+// https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki :
+//
+//	m / purpose' / coin_type' / account' / change / address_index
+//
+// It gives callers a structured, validated alternative to building a
+// DerivationPath by hand or parsing a free-form path string.
+type BIP44Params struct {
+	Purpose      uint32 `json:"purpose"`
+	CoinType     uint32 `json:"coin_type"`
+	Account      uint32 `json:"account"`
+	Change       bool   `json:"change"`
+	AddressIndex uint32 `json:"address_index"`
+}
+
+// NewBIP44Params builds a BIP44Params from its components. change selects
+// the external (false) or internal (true) chain.
+func NewBIP44Params(purpose, coinType, account uint32, change bool, addrIdx uint32) *BIP44Params {
+	return &BIP44Params{
+		Purpose:      purpose,
+		CoinType:     coinType,
+		Account:      account,
+		Change:       change,
+		AddressIndex: addrIdx,
+	}
+}
+
+// NewBIP44ParamsFromPath parses a canonical BIP-44 path string of the form
+// m/purpose'/coinType'/account'/change/addressIndex. The purpose, coinType
+// and account components must be hardened; change and addressIndex must not.
+func NewBIP44ParamsFromPath(path string) (*BIP44Params, error) {
+	dp, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(dp) != 5 {
+		return nil, fmt.Errorf("secp256k1: BIP-44 path must have exactly 5 levels, got %d", len(dp))
+	}
+	for i, name := range []string{"purpose", "coin type", "account"} {
+		if dp[i] < HardenedKeyStart {
+			return nil, fmt.Errorf("secp256k1: BIP-44 %s must be hardened", name)
+		}
+	}
+	for i, name := range []string{"change", "address index"} {
+		if dp[3+i] >= HardenedKeyStart {
+			return nil, fmt.Errorf("secp256k1: BIP-44 %s must not be hardened", name)
+		}
+	}
+
+	if dp[3] > 1 {
+		return nil, fmt.Errorf("secp256k1: BIP-44 change must be 0 or 1, got %d", dp[3])
+	}
+
+	change := dp[3] != 0
+	return &BIP44Params{
+		Purpose:      dp[0] - HardenedKeyStart,
+		CoinType:     dp[1] - HardenedKeyStart,
+		Account:      dp[2] - HardenedKeyStart,
+		Change:       change,
+		AddressIndex: dp[4],
+	}, nil
+}
+
+// String implements the stringer interface, yielding the canonical BIP-44
+// path representation m/purpose'/coinType'/account'/change/addressIndex.
+func (p *BIP44Params) String() string {
+	return p.DerivationPath().String()
+}
+
+// DerivationPath converts p into the binary DerivationPath accepted by
+// DeriveKey.
+func (p *BIP44Params) DerivationPath() DerivationPath {
+	var change uint32
+	if p.Change {
+		change = 1
+	}
+	return DerivationPath{
+		HardenedKeyStart + p.Purpose,
+		HardenedKeyStart + p.CoinType,
+		HardenedKeyStart + p.Account,
+		change,
+		p.AddressIndex,
+	}
+}
+
+// Derive walks master along p's derivation path, producing the leaf
+// extended key m/purpose'/coinType'/account'/change/addressIndex.
+func (p *BIP44Params) Derive(master *ExtendedKey) (*ExtendedKey, error) {
+	return DeriveKey(master, p.DerivationPath())
+}
@@ -0,0 +1,126 @@
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// bip85Purpose is the hardened purpose level reserved for BIP-85
+// "Deterministic Entropy From BIP-32 Keychains":
+// https://github.com/bitcoin/bips/blob/master/bip-0085.mediawiki
+const bip85Purpose = 83696968
+
+// bip85HMACKey is the fixed HMAC-SHA512 key used to derive entropy from a
+// child private key, per the BIP-85 spec.
+var bip85HMACKey = []byte("bip-entropy-from-k")
+
+const (
+	bip85AppBIP39 = 39
+	bip85AppWIF   = 2
+	bip85AppHex   = 128169
+)
+
+// DeriveEntropy derives the child key at path, which must be fully hardened,
+// and returns HMAC-SHA512(key="bip-entropy-from-k", msg=k_child) where
+// k_child is the child's 32-byte private key. The result is a 64-byte
+// entropy block that downstream appfunctions (DeriveBIP39, DeriveWIF,
+// DeriveHex, ...) slice to produce secrets of other types.
+func (k *ExtendedKey) DeriveEntropy(path DerivationPath) ([]byte, error) {
+	for _, component := range path {
+		if component < HardenedKeyStart {
+			return nil, fmt.Errorf("secp256k1: BIP-85 path %s must be fully hardened", path)
+		}
+	}
+
+	child, err := DeriveKey(k, path)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := child.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha512.New, bip85HMACKey)
+	mac.Write(priv.Serialize())
+	return mac.Sum(nil), nil
+}
+
+// DeriveBIP39 derives a BIP-39 mnemonic of wordCount words (one of 12, 18 or
+// 24) from the path m/83696968'/39'/language'/wordCount'/index'. Only
+// language 0 (English) is supported, since this package only bundles the
+// English wordlist.
+func (k *ExtendedKey) DeriveBIP39(language, wordCount, index uint32) (string, error) {
+	if language != 0 {
+		return "", fmt.Errorf("secp256k1: BIP-85 language %d not supported, only English (0) is bundled", language)
+	}
+	switch wordCount {
+	case 12, 18, 24:
+	default:
+		return "", fmt.Errorf("secp256k1: BIP-85 word count must be one of 12/18/24, got %d", wordCount)
+	}
+
+	path := DerivationPath{
+		HardenedKeyStart + bip85Purpose,
+		HardenedKeyStart + bip85AppBIP39,
+		HardenedKeyStart + language,
+		HardenedKeyStart + wordCount,
+		HardenedKeyStart + index,
+	}
+	entropy, err := k.DeriveEntropy(path)
+	if err != nil {
+		return "", err
+	}
+
+	// total_bits = wordCount*11 and checksum_bits = entropy_bits/32, so
+	// entropy_bits = total_bits*32/33 = wordCount*32/3; wordCount is always
+	// a multiple of 3 for the valid word counts, so this divides evenly.
+	entropyBytes := wordCount * 4 / 3
+	return entropyToMnemonic(entropy[:entropyBytes], DefaultWordlist)
+}
+
+// DeriveWIF derives a secp256k1 private key from the path
+// m/83696968'/2'/index' and returns it WIF-encoded for the Bitcoin mainnet.
+func (k *ExtendedKey) DeriveWIF(index uint32) (string, error) {
+	path := DerivationPath{
+		HardenedKeyStart + bip85Purpose,
+		HardenedKeyStart + bip85AppWIF,
+		HardenedKeyStart + index,
+	}
+	entropy, err := k.DeriveEntropy(path)
+	if err != nil {
+		return "", err
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), entropy[:32])
+	wif, err := btcutil.NewWIF(priv, &chaincfg.MainNetParams, true)
+	if err != nil {
+		return "", err
+	}
+	return wif.String(), nil
+}
+
+// DeriveHex derives numBytes (16 to 64) of raw hex entropy from the path
+// m/83696968'/128169'/numBytes'/index'.
+func (k *ExtendedKey) DeriveHex(numBytes, index uint32) ([]byte, error) {
+	if numBytes < 16 || numBytes > 64 {
+		return nil, fmt.Errorf("secp256k1: BIP-85 hex length must be in [16, 64], got %d", numBytes)
+	}
+
+	path := DerivationPath{
+		HardenedKeyStart + bip85Purpose,
+		HardenedKeyStart + bip85AppHex,
+		HardenedKeyStart + numBytes,
+		HardenedKeyStart + index,
+	}
+	entropy, err := k.DeriveEntropy(path)
+	if err != nil {
+		return nil, err
+	}
+	return entropy[:numBytes], nil
+}
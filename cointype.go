@@ -0,0 +1,79 @@
+package secp256k1
+
+import "fmt"
+
+// CoinType identifies a registered coin by its SLIP-44 index:
+// https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+type CoinType uint32
+
+// Well-known SLIP-44 coin types.
+const (
+	CoinTypeBTC  CoinType = 0
+	CoinTypeLTC  CoinType = 2
+	CoinTypeDOGE CoinType = 3
+	CoinTypeETH  CoinType = 60
+	CoinTypeETC  CoinType = 61
+	CoinTypeATOM CoinType = 118
+	CoinTypeFIL  CoinType = 461
+)
+
+// coinTypeNames maps registered coin types to their display name. It is
+// seeded with the constants above and can be extended at runtime via
+// Register.
+var coinTypeNames = map[CoinType]string{
+	CoinTypeBTC:  "BTC",
+	CoinTypeLTC:  "LTC",
+	CoinTypeDOGE: "DOGE",
+	CoinTypeETH:  "ETH",
+	CoinTypeETC:  "ETC",
+	CoinTypeATOM: "ATOM",
+	CoinTypeFIL:  "FIL",
+}
+
+// Register adds or overrides the display name for a SLIP-44 coin type,
+// letting callers extend the registry with coins not built into this
+// package.
+func Register(name string, coin CoinType) {
+	coinTypeNames[coin] = name
+}
+
+// String returns the registered name for c, or its bare numeric form if it
+// has not been registered.
+func (c CoinType) String() string {
+	if name, ok := coinTypeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", uint32(c))
+}
+
+// DefaultRootPath returns the root path m/44'/coin'/0'/0 (the external chain
+// of the first account) for the given coin type, matching the pattern used
+// by go-ethereum's hd.go.
+func DefaultRootPath(coin CoinType) DerivationPath {
+	return DerivationPath{
+		HardenedKeyStart + DefaultPurpose,
+		HardenedKeyStart + uint32(coin),
+		HardenedKeyStart,
+		0,
+	}
+}
+
+// DefaultBasePath returns the first address path m/44'/coin'/0'/0/0 for the
+// given coin type.
+func DefaultBasePath(coin CoinType) DerivationPath {
+	return append(DefaultRootPath(coin), 0)
+}
+
+// LegacyLedgerBasePath returns m/44'/coin'/0'/0, the non-standard path some
+// Ledger devices use in place of DefaultBasePath.
+func LegacyLedgerBasePath(coin CoinType) DerivationPath {
+	return DefaultRootPath(coin)
+}
+
+// ParseDerivationPathForCoin parses a relative derivation path against the
+// default base path for coin, so that ParseDerivationPathForCoin("0", coin)
+// yields m/44'/coin'/0'/0/0 without the caller constructing the base slice
+// by hand.
+func ParseDerivationPathForCoin(path string, coin CoinType) (DerivationPath, error) {
+	return ParseDerivationPath(path, DefaultRootPath(coin))
+}
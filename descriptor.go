@@ -0,0 +1,82 @@
+package secp256k1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// descriptorChecksumCharset is the character set accepted in an output
+// descriptor, grouped into 3 classes of (up to) 32 characters each. Its
+// position in this string, not its byte value, is what feeds the checksum
+// polynomial below.
+//
+// descriptorChecksumDigits is the base-32 alphabet the checksum itself is
+// encoded in.
+//
+// Both follow bitcoin-core's output descriptor checksum
+// (src/script/descriptor.cpp, DescriptorChecksum).
+const (
+	descriptorChecksumCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+		"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+		"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumDigits = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// descriptorPolyMod advances the descriptor checksum's GF(32) polynomial by
+// one symbol, mirroring bitcoin-core's PolyMod.
+func descriptorPolyMod(c uint64, val int) uint64 {
+	c0 := byte(c >> 35)
+	c = ((c & 0x7ffffffff) << 5) ^ uint64(val)
+	if c0&1 != 0 {
+		c ^= 0xf5dee51989
+	}
+	if c0&2 != 0 {
+		c ^= 0xa9fdca3312
+	}
+	if c0&4 != 0 {
+		c ^= 0x1bab10e32d
+	}
+	if c0&8 != 0 {
+		c ^= 0x3706b1677a
+	}
+	if c0&16 != 0 {
+		c ^= 0x644d626ffd
+	}
+	return c
+}
+
+// descriptorChecksum computes the 8-character checksum bitcoin-core appends
+// to an output descriptor after a `#`, so that the descriptor can be
+// accepted by `importmulti`/`importdescriptors` instead of being rejected as
+// unchecksummed.
+func descriptorChecksum(descriptor string) (string, error) {
+	var c uint64 = 1
+	cls, clscount := 0, 0
+
+	for _, ch := range descriptor {
+		pos := strings.IndexRune(descriptorChecksumCharset, ch)
+		if pos < 0 {
+			return "", fmt.Errorf("secp256k1: invalid descriptor character %q", ch)
+		}
+		c = descriptorPolyMod(c, pos&31)
+		cls = cls*3 + (pos >> 5)
+		clscount++
+		if clscount == 3 {
+			c = descriptorPolyMod(c, cls)
+			cls, clscount = 0, 0
+		}
+	}
+	if clscount > 0 {
+		c = descriptorPolyMod(c, cls)
+	}
+	for i := 0; i < 8; i++ {
+		c = descriptorPolyMod(c, 0)
+	}
+	c ^= 1
+
+	sum := make([]byte, 8)
+	for i := range sum {
+		sum[i] = descriptorChecksumDigits[(c>>uint(5*(7-i)))&31]
+	}
+	return string(sum), nil
+}
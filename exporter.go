@@ -0,0 +1,289 @@
+package secp256k1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// KeyExporter formats derived keys into the on-disk layout expected by a
+// specific wallet tool. ExportKeys drives the Header/Format/Trailer calls
+// needed to produce a full dump; implementations should be stateless, except
+// where the format itself requires accumulating state across keys (see
+// CliWatchOnly and Electrum).
+type KeyExporter interface {
+	// Header returns the text written once, before any key.
+	Header() string
+
+	// Format renders a single derived key. account is the hardened
+	// account-level key (m/purpose'/coinType'/account'), key is the leaf
+	// address key at account/branch/index, and addr is key's P2PKH address
+	// on params. branch is 0 for the external chain and 1 for the internal
+	// (change) chain; index is the address index within that branch.
+	Format(account, key *ExtendedKey, params *chaincfg.Params, addr string, branch, index uint32) (string, error)
+
+	// Trailer returns the text written once, after every key, given the
+	// highest address index covered by the dump.
+	Trailer(lastIdx uint32) string
+}
+
+// ExportKeys derives the external (branch 0) and internal (branch 1) chains
+// below each account-level path in paths, walks recoveryWindow addresses on
+// each branch starting at rescanFrom, and writes the resulting wallet dump
+// to w using exporter's format.
+func ExportKeys(master *ExtendedKey, paths []DerivationPath, params *chaincfg.Params, recoveryWindow, rescanFrom uint32, exporter KeyExporter, w io.Writer) error {
+	if _, err := io.WriteString(w, exporter.Header()); err != nil {
+		return err
+	}
+
+	var lastIdx uint32
+	for _, path := range paths {
+		account, err := DeriveKey(master, path)
+		if err != nil {
+			return fmt.Errorf("secp256k1: deriving account %s: %w", path, err)
+		}
+
+		for branch := uint32(0); branch < 2; branch++ {
+			chain, err := account.Child(branch)
+			if err != nil {
+				return fmt.Errorf("secp256k1: deriving branch %d of %s: %w", branch, path, err)
+			}
+
+			for i := uint32(0); i < recoveryWindow; i++ {
+				idx := rescanFrom + i
+				child, err := chain.Child(idx)
+				if err != nil {
+					return fmt.Errorf("secp256k1: deriving %s/%d/%d: %w", path, branch, idx, err)
+				}
+				address, err := child.Address(params)
+				if err != nil {
+					return err
+				}
+
+				line, err := exporter.Format(account, child, params, address.EncodeAddress(), branch, idx)
+				if err != nil {
+					return err
+				}
+				if _, err := io.WriteString(w, line); err != nil {
+					return err
+				}
+				if idx > lastIdx {
+					lastIdx = idx
+				}
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, exporter.Trailer(lastIdx))
+	return err
+}
+
+// Cli formats keys as a script of bitcoin-core `importprivkey` commands,
+// one per derived key.
+type Cli struct{}
+
+func (Cli) Header() string {
+	return "#!/usr/bin/env bash\nset -e\n"
+}
+
+func (Cli) Format(account, key *ExtendedKey, params *chaincfg.Params, addr string, branch, index uint32) (string, error) {
+	priv, err := key.ECPrivKey()
+	if err != nil {
+		return "", err
+	}
+	wif, err := btcutil.NewWIF(priv, params, true)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"bitcoin-cli importprivkey %s \"branch=%d/index=%d\" false # addr=%s\n",
+		wif.String(), branch, index, addr,
+	), nil
+}
+
+func (Cli) Trailer(lastIdx uint32) string {
+	return fmt.Sprintf("bitcoin-cli rescanblockchain # covers address indexes up to %d\n", lastIdx)
+}
+
+// cliWatchOnlyBranch tracks the address-index range seen for one
+// account/branch pair, so that a single ranged descriptor can cover it
+// instead of one entry per leaf address.
+type cliWatchOnlyBranch struct {
+	xpub   string
+	branch uint32
+	first  uint32
+	last   uint32
+}
+
+// CliWatchOnly formats keys as newline-delimited bitcoin-core `importmulti`
+// request objects, one ranged output descriptor per account/branch. A
+// `range` is only valid on a ranged descriptor, and `addr()` descriptors
+// require a `#checksum`, so Format cannot emit a usable entry per leaf
+// address; it instead buffers the index range seen per account/branch and
+// Trailer emits one `pkh(<account_xpub>/<branch>/*)#<checksum>` descriptor
+// per branch once the full range is known. Trailer ends the output with a
+// `#` comment line that is not JSON, so the caller is expected to drop the
+// `#` lines and collect the rest into a JSON array (e.g.
+// `grep -v '^#' | jq -s .`) before handing them to `bitcoin-cli importmulti`.
+type CliWatchOnly struct {
+	branches map[string]*cliWatchOnlyBranch
+	order    []string
+}
+
+func (e *CliWatchOnly) Header() string {
+	return ""
+}
+
+func (e *CliWatchOnly) Format(account, key *ExtendedKey, params *chaincfg.Params, addr string, branch, index uint32) (string, error) {
+	neutered, err := account.Neuter()
+	if err != nil {
+		return "", err
+	}
+	xpub := neutered.String()
+	id := fmt.Sprintf("%s/%d", xpub, branch)
+
+	if e.branches == nil {
+		e.branches = make(map[string]*cliWatchOnlyBranch)
+	}
+	b, ok := e.branches[id]
+	if !ok {
+		e.branches[id] = &cliWatchOnlyBranch{xpub: xpub, branch: branch, first: index, last: index}
+		e.order = append(e.order, id)
+		return "", nil
+	}
+	if index < b.first {
+		b.first = index
+	}
+	if index > b.last {
+		b.last = index
+	}
+	return "", nil
+}
+
+func (e *CliWatchOnly) Trailer(lastIdx uint32) string {
+	var out strings.Builder
+	for _, id := range e.order {
+		b := e.branches[id]
+		descNoChecksum := fmt.Sprintf("pkh(%s/%d/*)", b.xpub, b.branch)
+		checksum, err := descriptorChecksum(descNoChecksum)
+		if err != nil {
+			fmt.Fprintf(&out, "# error computing descriptor checksum for %s: %v\n", descNoChecksum, err)
+			continue
+		}
+		entry := map[string]interface{}{
+			"desc":      fmt.Sprintf("%s#%s", descNoChecksum, checksum),
+			"range":     [2]uint32{b.first, b.last},
+			"timestamp": "now",
+			"watchonly": true,
+			"keypool":   true,
+			"internal":  b.branch == 1,
+		}
+		line, err := json.Marshal(entry)
+		if err != nil {
+			fmt.Fprintf(&out, "# error encoding importmulti entry for %s: %v\n", id, err)
+			continue
+		}
+		out.Write(line)
+		out.WriteByte('\n')
+	}
+	fmt.Fprintf(&out, "# import covers address indexes up to %d\n", lastIdx)
+	return out.String()
+}
+
+// ImportWallet formats keys in the bitcoind `dumpwallet` / `importwallet`
+// format: one WIF per line, labeled with its derivation branch/index and
+// trailed by its address as a comment.
+type ImportWallet struct{}
+
+func (ImportWallet) Header() string {
+	return "# Wallet dump created by secp256k1 ExportKeys\n"
+}
+
+func (ImportWallet) Format(account, key *ExtendedKey, params *chaincfg.Params, addr string, branch, index uint32) (string, error) {
+	priv, err := key.ECPrivKey()
+	if err != nil {
+		return "", err
+	}
+	wif, err := btcutil.NewWIF(priv, params, true)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"%s label=branch=%d/index=%d timestamp=%s # addr=%s\n",
+		wif.String(), branch, index, time.Now().UTC().Format(time.RFC3339), addr,
+	), nil
+}
+
+func (ImportWallet) Trailer(lastIdx uint32) string {
+	return fmt.Sprintf("# End of dump, covers address indexes up to %d\n", lastIdx)
+}
+
+// Electrum formats keys as an Electrum JSON wallet file. Format accumulates
+// addresses into the wallet's address pool; the xpub/xprv are only known
+// once the first key of the first account has been seen, and the default gap
+// limit only once the highest index is known, so the wallet structure is
+// emitted lazily and finalized in Trailer. Electrum
+// itself derives addresses as xpub/branch/index, so the wallet must be
+// seeded from the account-level key, not a leaf key, or the addresses/
+// change_addresses lists won't match what restoring from xprv derives.
+// Electrum wallets hold a single account; paths spanning more than one
+// account are rejected.
+type Electrum struct {
+	GapLimit uint32
+
+	seeded      bool
+	masterXpub  string
+	masterXprv  string
+	addresses   []string
+	changeAddrs []string
+}
+
+func (e *Electrum) Header() string {
+	return ""
+}
+
+func (e *Electrum) Format(account, key *ExtendedKey, params *chaincfg.Params, addr string, branch, index uint32) (string, error) {
+	if !e.seeded {
+		neutered, err := account.Neuter()
+		if err != nil {
+			return "", err
+		}
+		e.masterXpub = neutered.String()
+		e.masterXprv = account.String()
+		e.seeded = true
+	} else if accountXprv := account.String(); accountXprv != e.masterXprv {
+		return "", fmt.Errorf("secp256k1: Electrum export only supports a single account, got both %s and %s", e.masterXprv, accountXprv)
+	}
+	if branch == 1 {
+		e.changeAddrs = append(e.changeAddrs, addr)
+	} else {
+		e.addresses = append(e.addresses, addr)
+	}
+	return "", nil
+}
+
+func (e *Electrum) Trailer(lastIdx uint32) string {
+	gapLimit := e.GapLimit
+	if gapLimit == 0 {
+		gapLimit = lastIdx + 1
+	}
+	wallet := map[string]interface{}{
+		"xpub":             e.masterXpub,
+		"xprv":             e.masterXprv,
+		"addresses":        e.addresses,
+		"change_addresses": e.changeAddrs,
+		"gap_limit":        gapLimit,
+		"wallet_type":      "standard",
+		"use_encryption":   false,
+	}
+	b, err := json.MarshalIndent(wallet, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("# error encoding electrum wallet: %v\n", err)
+	}
+	return string(b) + "\n"
+}
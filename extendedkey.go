@@ -42,6 +42,14 @@ func (k *ExtendedKey) Child(i uint32) (*ExtendedKey, error) {
 	return &ExtendedKey{*key}, nil
 }
 
+func (k *ExtendedKey) Neuter() (*ExtendedKey, error) {
+	key, err := k.ExtendedKey.Neuter()
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendedKey{*key}, nil
+}
+
 func (k *ExtendedKey) MarshalJSON() ([]byte, error) {
 	return json.Marshal(k.String())
 }
@@ -0,0 +1,149 @@
+package secp256k1
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	mnemonicPBKDF2Iterations = 2048
+	mnemonicSeedSize         = 64
+)
+
+// Wordlist is a BIP-39 word list: exactly 2048 entries, sorted so that a
+// binary search can be used to recover the index of a word on restore.
+type Wordlist []string
+
+// DefaultWordlist is the English wordlist used by GenerateMnemonic and
+// ValidateMnemonic unless DefaultWordlist is reassigned.
+var DefaultWordlist Wordlist = englishWordlist
+
+var errInvalidMnemonic = errors.New("secp256k1: invalid mnemonic")
+
+// GenerateMnemonic creates a new BIP-39 mnemonic phrase from a freshly
+// generated random entropy pool. bitsOfEntropy must be one of 128, 160, 192,
+// 224 or 256, yielding 12, 15, 18, 21 or 24 words respectively.
+func GenerateMnemonic(bitsOfEntropy int) (string, error) {
+	switch bitsOfEntropy {
+	case 128, 160, 192, 224, 256:
+	default:
+		return "", fmt.Errorf("secp256k1: invalid entropy size %d, must be one of 128/160/192/224/256", bitsOfEntropy)
+	}
+
+	entropy := make([]byte, bitsOfEntropy/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	return entropyToMnemonic(entropy, DefaultWordlist)
+}
+
+// entropyToMnemonic converts raw entropy into its mnemonic sentence per
+// https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+func entropyToMnemonic(entropy []byte, wordlist Wordlist) (string, error) {
+	entropyBits := len(entropy) * 8
+	checksumBits := entropyBits / 32
+
+	checksum := sha256.Sum256(entropy)
+	bits := append(append([]byte{}, entropy...), checksum[:]...)
+
+	numWords := (entropyBits + checksumBits) / 11
+	words := make([]string, numWords)
+	for i := 0; i < numWords; i++ {
+		idx := bitsToUint11(bits, i*11)
+		words[i] = wordlist[idx]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// bitsToUint11 reads an 11-bit big-endian value out of bits starting at the
+// given bit offset.
+func bitsToUint11(bits []byte, offset int) int {
+	var v int
+	for i := 0; i < 11; i++ {
+		bitPos := offset + i
+		bytePos := bitPos / 8
+		bitInByte := 7 - uint(bitPos%8)
+		bit := (bits[bytePos] >> bitInByte) & 1
+		v = v<<1 | int(bit)
+	}
+	return v
+}
+
+// ValidateMnemonic checks that m is a well-formed BIP-39 mnemonic: every word
+// must appear in DefaultWordlist and the embedded checksum must match the
+// recovered entropy.
+func ValidateMnemonic(m string) error {
+	_, err := mnemonicToEntropy(m, DefaultWordlist)
+	return err
+}
+
+// mnemonicToEntropy recovers the original entropy bytes from a mnemonic,
+// verifying its checksum in the process.
+func mnemonicToEntropy(mnemonic string, wordlist Wordlist) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("%w: word count %d is not one of 12/15/18/21/24", errInvalidMnemonic, len(words))
+	}
+
+	index := make(map[string]int, len(wordlist))
+	for i, w := range wordlist {
+		index[w] = i
+	}
+
+	totalBits := len(words) * 11
+	bits := make([]byte, (totalBits+7)/8)
+	for i, w := range words {
+		idx, ok := index[w]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q", errInvalidMnemonic, w)
+		}
+		for b := 0; b < 11; b++ {
+			bitPos := i*11 + b
+			if idx&(1<<uint(10-b)) != 0 {
+				bits[bitPos/8] |= 1 << uint(7-bitPos%8)
+			}
+		}
+	}
+
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+	entropy := bits[:entropyBits/8]
+
+	checksum := sha256.Sum256(entropy)
+	for i := 0; i < checksumBits; i++ {
+		want := (checksum[i/8] >> uint(7-i%8)) & 1
+		bitPos := entropyBits + i
+		got := (bits[bitPos/8] >> uint(7-bitPos%8)) & 1
+		if want != got {
+			return nil, fmt.Errorf("%w: checksum mismatch", errInvalidMnemonic)
+		}
+	}
+	return entropy, nil
+}
+
+// MnemonicToSeed stretches a mnemonic and optional passphrase into a 64-byte
+// seed suitable for NewMaster, using PBKDF2-HMAC-SHA512 with 2048 iterations
+// as specified by BIP-39. The mnemonic is not validated; callers that need to
+// reject malformed phrases should call ValidateMnemonic first.
+func MnemonicToSeed(mnemonic, passphrase string) []byte {
+	salt := "mnemonic" + passphrase
+	return pbkdf2.Key([]byte(mnemonic), []byte(salt), mnemonicPBKDF2Iterations, mnemonicSeedSize, sha512.New)
+}
+
+// NewMasterFromMnemonic derives the master extended key directly from a BIP-39
+// mnemonic and passphrase, combining MnemonicToSeed and NewMaster.
+func NewMasterFromMnemonic(mnemonic, passphrase string, net *chaincfg.Params) (*ExtendedKey, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return NewMaster(MnemonicToSeed(mnemonic, passphrase), net)
+}
@@ -0,0 +1,133 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// Test vectors from the widely used Trezor BIP-39 vector set
+// (https://github.com/trezor/python-mnemonic/blob/master/vectors.json),
+// using the fixed passphrase "TREZOR".
+var mnemonicVectors = []struct {
+	entropyHex string
+	mnemonic   string
+	seedHex    string
+}{
+	{
+		entropyHex: "00000000000000000000000000000000",
+		mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seedHex:    "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropyHex: "0000000000000000000000000000000000000000000000000000000000000000",
+		mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		seedHex:    "bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+}
+
+func TestEntropyToMnemonicVectors(t *testing.T) {
+	for _, v := range mnemonicVectors {
+		entropy, err := hex.DecodeString(v.entropyHex)
+		if err != nil {
+			t.Fatalf("bad test vector entropy: %v", err)
+		}
+		got, err := entropyToMnemonic(entropy, DefaultWordlist)
+		if err != nil {
+			t.Fatalf("entropyToMnemonic(%s) returned error: %v", v.entropyHex, err)
+		}
+		if got != v.mnemonic {
+			t.Errorf("entropyToMnemonic(%s) = %q, want %q", v.entropyHex, got, v.mnemonic)
+		}
+	}
+}
+
+func TestMnemonicToSeedVectors(t *testing.T) {
+	for _, v := range mnemonicVectors {
+		if err := ValidateMnemonic(v.mnemonic); err != nil {
+			t.Fatalf("ValidateMnemonic(%q) returned error: %v", v.mnemonic, err)
+		}
+		seed := MnemonicToSeed(v.mnemonic, "TREZOR")
+		if got := hex.EncodeToString(seed); got != v.seedHex {
+			t.Errorf("MnemonicToSeed(%q, TREZOR) = %s, want %s", v.mnemonic, got, v.seedHex)
+		}
+	}
+}
+
+func TestValidateMnemonicRejectsCorruption(t *testing.T) {
+	base := mnemonicVectors[0].mnemonic
+	words := strings.Fields(base)
+
+	t.Run("unknown word", func(t *testing.T) {
+		corrupt := append(append([]string{}, words[:len(words)-1]...), "notaword")
+		if err := ValidateMnemonic(strings.Join(corrupt, " ")); err == nil {
+			t.Fatal("expected error for unknown word, got nil")
+		}
+	})
+
+	t.Run("bad checksum", func(t *testing.T) {
+		corrupt := append([]string{}, words...)
+		// Swap the final (checksum-bearing) word for another valid word,
+		// which should desync the embedded checksum.
+		if corrupt[len(corrupt)-1] == "zoo" {
+			corrupt[len(corrupt)-1] = "zero"
+		} else {
+			corrupt[len(corrupt)-1] = "zoo"
+		}
+		if err := ValidateMnemonic(strings.Join(corrupt, " ")); err == nil {
+			t.Fatal("expected checksum error, got nil")
+		}
+	})
+
+	t.Run("wrong word count", func(t *testing.T) {
+		if err := ValidateMnemonic(strings.Join(words[:len(words)-1], " ")); err == nil {
+			t.Fatal("expected word count error, got nil")
+		}
+	})
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	cases := []struct {
+		bits      int
+		wordCount int
+	}{
+		{128, 12},
+		{160, 15},
+		{192, 18},
+		{224, 21},
+		{256, 24},
+	}
+	for _, c := range cases {
+		m, err := GenerateMnemonic(c.bits)
+		if err != nil {
+			t.Fatalf("GenerateMnemonic(%d) returned error: %v", c.bits, err)
+		}
+		if got := len(strings.Fields(m)); got != c.wordCount {
+			t.Errorf("GenerateMnemonic(%d) produced %d words, want %d", c.bits, got, c.wordCount)
+		}
+		if err := ValidateMnemonic(m); err != nil {
+			t.Errorf("GenerateMnemonic(%d) produced a mnemonic that failed validation: %v", c.bits, err)
+		}
+	}
+
+	if _, err := GenerateMnemonic(100); err == nil {
+		t.Fatal("expected error for invalid entropy size, got nil")
+	}
+}
+
+func TestNewMasterFromMnemonic(t *testing.T) {
+	m := mnemonicVectors[0].mnemonic
+	key, err := NewMasterFromMnemonic(m, "TREZOR", &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("NewMasterFromMnemonic returned error: %v", err)
+	}
+	if key == nil {
+		t.Fatal("NewMasterFromMnemonic returned a nil key")
+	}
+
+	if _, err := NewMasterFromMnemonic("not a valid mnemonic at all", "TREZOR", &chaincfg.MainNetParams); err == nil {
+		t.Fatal("expected error for invalid mnemonic, got nil")
+	}
+}